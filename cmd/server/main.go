@@ -51,6 +51,9 @@ func main() {
 	}
 
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
 
 	db, err := sql.Open("postgres", cfg.DatabaseURL)
 	if err != nil {
@@ -71,9 +74,9 @@ func main() {
 	urlRepo := postgres.NewURLRepository(db)
 	cacheRepo := redisRepo.NewCacheRepository(rdb)
 
-	urlService := service.NewURLService(urlRepo, cacheRepo, cfg.BaseURL)
+	urlService := service.NewURLService(urlRepo, cacheRepo, cfg.BaseURL, cfg.RequireHTTPSDestinations, cfg.APIKey)
 
-	urlHandler := handler.NewURLHandler(urlService)
+	urlHandler := handler.NewURLHandler(urlService, cfg.Branding)
 
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -90,6 +93,7 @@ func main() {
 	api := router.Group("/api/v1")
 	{
 		api.POST("/urls", middleware.APIKeyAuth(cfg.APIKey), urlHandler.CreateShortURL)
+		api.GET("/urls/recent", middleware.APIKeyAuth(cfg.APIKey), urlHandler.ListRecentURLs)
 		api.GET("/urls/:id", middleware.APIKeyAuth(cfg.APIKey), urlHandler.GetURLInfo)
 		api.GET("/urls", middleware.APIKeyAuth(cfg.APIKey), urlHandler.ListURLs)
 		api.DELETE("/urls/:id", middleware.APIKeyAuth(cfg.APIKey), urlHandler.DeleteURL)
@@ -97,6 +101,15 @@ func main() {
 		api.GET("/urls/:id/analytics", middleware.APIKeyAuth(cfg.APIKey), urlHandler.GetAnalytics)
 	}
 
+	// 관리자 라우트 (ADMIN_API_KEY 설정 시에만 활성화)
+	if cfg.AdminAPIKey != "" {
+		admin := router.Group("/api/v1/admin", middleware.APIKeyAuth(cfg.AdminAPIKey))
+		{
+			admin.POST("/urls/transfer", urlHandler.BulkTransferURLs)
+			admin.POST("/urls/:id/transfer", urlHandler.TransferURL)
+		}
+	}
+
 	// Swagger UI 라우트
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -0,0 +1,99 @@
+package config
+
+import "testing"
+
+func TestLoadRequireHTTPSDestinations(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{"unset", "", false, false},
+		{"true", "true", true, false},
+		{"false", "false", false, false},
+		{"numeric", "1", true, false},
+		{"unparsable", "yes", false, true},
+		{"typo", "ture", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("REQUIRE_HTTPS_DESTINATIONS", tt.value)
+
+			cfg := Load()
+			if cfg.RequireHTTPSDestinations != tt.want {
+				t.Errorf("RequireHTTPSDestinations = %v, want %v", cfg.RequireHTTPSDestinations, tt.want)
+			}
+
+			err := cfg.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBrandingValidate(t *testing.T) {
+	valid := Branding{
+		Name:         "marsboy.dev",
+		LogoURL:      "https://marsboy.dev/logo.png",
+		SupportEmail: "help@marsboy.dev",
+		PrimaryColor: "#4f46e5",
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(b *Branding)
+		wantErr bool
+	}{
+		{"valid", func(b *Branding) {}, false},
+		{"optional fields empty", func(b *Branding) { b.LogoURL = ""; b.SupportEmail = "" }, false},
+		{"short hex color", func(b *Branding) { b.PrimaryColor = "#fff" }, false},
+		{"relative logo URL", func(b *Branding) { b.LogoURL = "/logo.png" }, true},
+		{"javascript logo URL", func(b *Branding) { b.LogoURL = "javascript:alert(1)" }, true},
+		{"invalid email", func(b *Branding) { b.SupportEmail = "not-an-email" }, true},
+		{"email with display name", func(b *Branding) { b.SupportEmail = "Help <help@marsboy.dev>" }, true},
+		{"named color", func(b *Branding) { b.PrimaryColor = "red" }, true},
+		{"hex color without hash", func(b *Branding) { b.PrimaryColor = "4f46e5" }, true},
+		{"empty color", func(b *Branding) { b.PrimaryColor = "" }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := valid
+			tt.modify(&b)
+
+			err := b.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAdminAPIKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiKey      string
+		adminAPIKey string
+		wantErr     bool
+	}{
+		{"admin key unset", "sk_marsboy_dev_key", "", false},
+		{"distinct keys", "sk_marsboy_dev_key", "sk_marsboy_admin_key", false},
+		{"same as API key", "sk_marsboy_dev_key", "sk_marsboy_dev_key", true},
+		{"same as API key with whitespace", "sk_marsboy_dev_key", " sk_marsboy_dev_key ", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("API_KEY", tt.apiKey)
+			t.Setenv("ADMIN_API_KEY", tt.adminAPIKey)
+
+			err := Load().Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -24,7 +24,7 @@ type URL struct {
 type CreateURLRequest struct {
 	OriginalURL string     `json:"original_url" binding:"required,url,max=2048" example:"https://github.com/username/awesome-project/blob/main/README.md" format:"uri" description:"단축할 원본 URL (최대 2048자)"`
 	CustomID    *string    `json:"custom_id,omitempty" binding:"omitempty,min=3,max=50" example:"my-project" minLength:"3" maxLength:"50" description:"커스텀 식별자 (3-50자, 영숫자와 하이픈만)"`
-	ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z" format:"date-time" description:"만료 일시 (ISO 8601 형식)"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" example:"2025-12-31T23:59:59Z" format:"date-time" description:"만료 일시 (ISO 8601 형식, 해당 시각부터 만료)"`
 	Description *string    `json:"description,omitempty" binding:"omitempty,max=255" example:"My awesome project repository" maxLength:"255" description:"URL 설명 (최대 255자)"`
 }
 
@@ -57,6 +57,29 @@ type URLListOptions struct {
 	IsActive *bool  `form:"is_active,omitempty"`
 }
 
+type RecentURLOptions struct {
+	// 포인터로 받아 미지정(기본값 10)과 limit=0(400 에러)을 구분
+	Limit *int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+type RecentURLsResponse struct {
+	URLs []URL `json:"urls" description:"최근 생성된 활성 URL 목록 (created_at 내림차순)"`
+}
+
+type TransferURLRequest struct {
+	ToAPIKey string `json:"to_api_key" binding:"required,max=255" example:"sk_marsboy_new_key" description:"새 소유자 API 키"`
+}
+
+type BulkTransferURLRequest struct {
+	IDs      []string `json:"ids" binding:"required,min=1,max=100,dive,required" example:"my-project,blog" description:"이전할 단축 URL ID 목록 (최대 100개)"`
+	ToAPIKey string   `json:"to_api_key" binding:"required,max=255" example:"sk_marsboy_new_key" description:"새 소유자 API 키"`
+}
+
+type TransferURLResponse struct {
+	IDs              []string `json:"ids" description:"소유권이 이전된 URL ID 목록 (이미 대상 키 소유였던 URL 제외)"`
+	TransferredCount int      `json:"transferred_count" example:"2" minimum:"0" description:"이전된 URL 수"`
+}
+
 func NewURL(id, originalURL string, description *string, expiresAt *time.Time, apiKey string) *URL {
 	now := time.Now()
 	return &URL{
@@ -72,15 +95,27 @@ func NewURL(id, originalURL string, description *string, expiresAt *time.Time, a
 	}
 }
 
+// IsExpired는 현재 시각 기준으로 만료 여부를 반환합니다
 func (u *URL) IsExpired() bool {
+	return u.IsExpiredAt(time.Now())
+}
+
+// IsExpiredAt은 주어진 시각 기준으로 만료 여부를 반환합니다
+// expires_at은 포함(inclusive) 경계입니다: 정확히 expires_at 시각부터 만료된 것으로 봅니다
+func (u *URL) IsExpiredAt(now time.Time) bool {
 	if u.ExpiresAt == nil {
 		return false
 	}
-	return time.Now().After(*u.ExpiresAt)
+	return !now.Before(*u.ExpiresAt)
 }
 
 func (u *URL) IsAccessible() bool {
-	return u.IsActive && !u.IsExpired()
+	return u.IsAccessibleAt(time.Now())
+}
+
+// IsAccessibleAt은 주어진 시각 기준으로 접근 가능 여부를 반환합니다
+func (u *URL) IsAccessibleAt(now time.Time) bool {
+	return u.IsActive && !u.IsExpiredAt(now)
 }
 
 func (u *URL) IncrementClickCount() {
@@ -97,7 +132,9 @@ func (u *URL) BuildQRCodeURL(baseURL string) {
 	u.QRCodeURL = strings.TrimRight(baseURL, "/") + "/api/v1/urls/" + u.ID + "/qr"
 }
 
-func ValidateOriginalURL(rawURL string) error {
+// ValidateOriginalURL은 원본 URL을 검사합니다
+// requireHTTPS가 true이면 https 목적지만 허용합니다
+func ValidateOriginalURL(rawURL string, requireHTTPS bool) error {
 	if rawURL == "" {
 		return NewValidationError("original_url", "URL is required")
 	}
@@ -111,6 +148,10 @@ func ValidateOriginalURL(rawURL string) error {
 		return NewValidationError("original_url", "URL must be http or https")
 	}
 
+	if requireHTTPS && parsed.Scheme != "https" {
+		return NewValidationError("original_url", "URL must use https")
+	}
+
 	if parsed.Host == "" {
 		return NewValidationError("original_url", "URL must have a valid host")
 	}
@@ -134,15 +175,26 @@ func ValidateCustomID(customID string) error {
 	}
 
 	// 예약된 키워드 확인
-	reservedWords := []string{"api", "health", "admin", "www", "app", "dev", "stage", "prod"}
-	lowerID := strings.ToLower(customID)
+	if IsReservedID(customID) {
+		return NewValidationError("custom_id", "Custom ID cannot use reserved word: "+strings.ToLower(customID))
+	}
+
+	return nil
+}
+
+// reservedWords는 라우트 경로와 겹쳐 단축 URL ID로 쓸 수 없는 키워드입니다
+// (예: "recent"는 GET /api/v1/urls/recent가 GET /api/v1/urls/:id보다 우선함)
+var reservedWords = []string{"api", "health", "admin", "www", "app", "dev", "stage", "prod", "recent"}
+
+// IsReservedID는 ID가 예약된 키워드인지 대소문자 구분 없이 확인합니다
+func IsReservedID(id string) bool {
+	lowerID := strings.ToLower(id)
 	for _, word := range reservedWords {
 		if lowerID == word {
-			return NewValidationError("custom_id", "Custom ID cannot use reserved word: "+word)
+			return true
 		}
 	}
-
-	return nil
+	return false
 }
 
 type ValidationError struct {
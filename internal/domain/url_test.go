@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestURLExpiryBoundary(t *testing.T) {
+	expiresAt := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		wantExpired bool
+	}{
+		{"1ns before expires_at", expiresAt.Add(-time.Nanosecond), false},
+		{"exactly at expires_at", expiresAt, true},
+		{"1ns after expires_at", expiresAt.Add(time.Nanosecond), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &URL{IsActive: true, ExpiresAt: &expiresAt}
+
+			if got := u.IsExpiredAt(tt.now); got != tt.wantExpired {
+				t.Errorf("IsExpiredAt() = %v, want %v", got, tt.wantExpired)
+			}
+			if got := u.IsAccessibleAt(tt.now); got != !tt.wantExpired {
+				t.Errorf("IsAccessibleAt() = %v, want %v", got, !tt.wantExpired)
+			}
+		})
+	}
+}
+
+func TestURLWithoutExpiry(t *testing.T) {
+	u := &URL{IsActive: true}
+	now := time.Now()
+
+	if u.IsExpiredAt(now) {
+		t.Error("IsExpiredAt() = true, want false for nil ExpiresAt")
+	}
+	if !u.IsAccessibleAt(now) {
+		t.Error("IsAccessibleAt() = false, want true for nil ExpiresAt")
+	}
+
+	u.IsActive = false
+	if u.IsAccessibleAt(now) {
+		t.Error("IsAccessibleAt() = true, want false for inactive URL")
+	}
+}
+
+func TestValidateOriginalURLScheme(t *testing.T) {
+	tests := []struct {
+		name         string
+		rawURL       string
+		requireHTTPS bool
+		wantMessage  string
+	}{
+		{"http allowed by default", "http://example.com", false, ""},
+		{"https allowed by default", "https://example.com", false, ""},
+		{"http rejected when https required", "http://example.com", true, "URL must use https"},
+		{"https allowed when https required", "https://example.com", true, ""},
+		{"ftp rejected by default", "ftp://example.com", false, "URL must be http or https"},
+		{"ftp rejected by scheme check first", "ftp://example.com", true, "URL must be http or https"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOriginalURL(tt.rawURL, tt.requireHTTPS)
+			if tt.wantMessage == "" {
+				if err != nil {
+					t.Errorf("ValidateOriginalURL() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantMessage {
+				t.Errorf("ValidateOriginalURL() = %v, want %q", err, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestValidateCustomIDReservedWords(t *testing.T) {
+	for _, id := range []string{"recent", "Recent", "admin", "health"} {
+		if err := ValidateCustomID(id); err == nil {
+			t.Errorf("ValidateCustomID(%q) = nil, want reserved word error", id)
+		}
+	}
+
+	if err := ValidateCustomID("recently"); err != nil {
+		t.Errorf("ValidateCustomID(%q) = %v, want nil", "recently", err)
+	}
+}
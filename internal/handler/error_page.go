@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"html/template"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+
+	"go-url-shortener/internal/config"
+	"go-url-shortener/internal/service"
+)
+
+// 리다이렉트 실패 시 브라우저에 보여줄 기본 에러 페이지
+var errorPageTemplate = template.Must(template.New("error_page").Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}} - {{.Brand.Name}}</title>
+<style>
+body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; background: #f8fafc; color: #1e293b; }
+main { max-width: 480px; margin: 15vh auto 0; padding: 0 24px; text-align: center; }
+img { max-height: 48px; margin-bottom: 24px; }
+h1 { font-size: 1.5rem; color: {{.Brand.PrimaryColor}}; }
+p { line-height: 1.6; }
+a { color: {{.Brand.PrimaryColor}}; }
+footer { margin-top: 48px; font-size: 0.875rem; color: #64748b; }
+</style>
+</head>
+<body>
+<main>
+{{if .Brand.LogoURL}}<img src="{{.Brand.LogoURL}}" alt="{{.Brand.Name}}">{{end}}
+<h1>{{.Title}}</h1>
+<p>{{.Message}}</p>
+<footer>
+{{.Brand.Name}}{{if .Brand.SupportEmail}} · <a href="mailto:{{.Brand.SupportEmail}}">{{.Brand.SupportEmail}}</a>{{end}}
+</footer>
+</main>
+</body>
+</html>
+`))
+
+type errorPageData struct {
+	Brand   config.Branding
+	Title   string
+	Message string
+}
+
+// wantsHTML은 요청이 브라우저에서 온 것인지 Accept 헤더로 판단합니다
+func wantsHTML(c *gin.Context) bool {
+	return strings.Contains(c.GetHeader("Accept"), "text/html")
+}
+
+func (h *URLHandler) renderErrorPage(c *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	data := errorPageData{
+		Brand:   h.branding,
+		Title:   "문제가 발생했습니다",
+		Message: "요청을 처리하는 중 오류가 발생했습니다. 잠시 후 다시 시도해주세요.",
+	}
+
+	if serviceErr, ok := err.(*service.ServiceError); ok {
+		statusCode = h.getHTTPStatusFromErrorCode(serviceErr.Code)
+		switch serviceErr.Code {
+		case service.ErrCodeNotFound:
+			data.Title = "링크를 찾을 수 없습니다"
+			data.Message = "요청하신 단축 URL이 존재하지 않거나 삭제되었습니다."
+		case service.ErrCodeExpired:
+			data.Title = "만료된 링크입니다"
+			data.Message = "요청하신 단축 URL은 유효 기간이 지나 더 이상 사용할 수 없습니다."
+		}
+	}
+
+	c.Render(statusCode, render.HTML{
+		Template: errorPageTemplate,
+		Data:     data,
+	})
+}
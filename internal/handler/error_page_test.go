@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-url-shortener/internal/config"
+	"go-url-shortener/internal/domain"
+	"go-url-shortener/internal/repository/interfaces"
+	"go-url-shortener/internal/service"
+)
+
+// 리다이렉트 경로에서 사용하는 메서드만 구현한 테스트용 저장소
+type fakeURLRepository struct {
+	interfaces.URLRepository
+	urls map[string]*domain.URL
+}
+
+func (r *fakeURLRepository) GetByID(ctx context.Context, id string) (*domain.URL, error) {
+	if url, ok := r.urls[id]; ok {
+		return url, nil
+	}
+	return nil, fmt.Errorf("URL with ID '%s' not found", id)
+}
+
+type fakeCacheRepository struct {
+	interfaces.CacheRepository
+}
+
+func (r *fakeCacheRepository) GetURL(ctx context.Context, id string) (*domain.URL, error) {
+	return nil, errors.New("cache miss")
+}
+
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	expiredAt := time.Now().Add(-time.Hour)
+	urlRepo := &fakeURLRepository{
+		urls: map[string]*domain.URL{
+			"expired": {ID: "expired", OriginalURL: "https://example.com", IsActive: true, ExpiresAt: &expiredAt},
+		},
+	}
+	urlService := service.NewURLService(urlRepo, &fakeCacheRepository{}, "http://localhost:8080", false, "sk_test")
+	urlHandler := NewURLHandler(urlService, config.Branding{
+		Name:         "marsboy.dev",
+		LogoURL:      "https://marsboy.dev/logo.png",
+		SupportEmail: "help@marsboy.dev",
+		PrimaryColor: "#1a73e8",
+	})
+
+	router := gin.New()
+	router.GET("/:id", urlHandler.RedirectURL)
+	return router
+}
+
+func TestRedirectErrorPage(t *testing.T) {
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		path       string
+		accept     string
+		wantStatus int
+		wantHTML   bool
+		wantBody   string
+	}{
+		{"missing link as HTML", "/missing", "text/html,application/xhtml+xml", http.StatusNotFound, true, "링크를 찾을 수 없습니다"},
+		{"expired link as HTML", "/expired", "text/html", http.StatusGone, true, "만료된 링크입니다"},
+		{"missing link as JSON by default", "/missing", "", http.StatusNotFound, false, `"error":"not_found"`},
+		{"expired link as JSON for API clients", "/expired", "application/json", http.StatusGone, false, `"error":"expired"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+
+			contentType := w.Header().Get("Content-Type")
+			body := w.Body.String()
+			if tt.wantHTML {
+				if !strings.HasPrefix(contentType, "text/html") {
+					t.Errorf("Content-Type = %q, want text/html", contentType)
+				}
+				for _, branding := range []string{"marsboy.dev", "https://marsboy.dev/logo.png", "mailto:help@marsboy.dev", "#1a73e8"} {
+					if !strings.Contains(body, branding) {
+						t.Errorf("body does not contain branding value %q", branding)
+					}
+				}
+			} else if !strings.HasPrefix(contentType, "application/json") {
+				t.Errorf("Content-Type = %q, want application/json", contentType)
+			}
+
+			if !strings.Contains(body, tt.wantBody) {
+				t.Errorf("body does not contain %q:\n%s", tt.wantBody, body)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"go-url-shortener/internal/config"
 	"go-url-shortener/internal/domain"
 	"go-url-shortener/internal/middleware"
 	"go-url-shortener/internal/service"
@@ -13,11 +14,13 @@ import (
 
 type URLHandler struct {
 	urlService *service.URLService
+	branding   config.Branding
 }
 
-func NewURLHandler(urlService *service.URLService) *URLHandler {
+func NewURLHandler(urlService *service.URLService, branding config.Branding) *URLHandler {
 	return &URLHandler{
 		urlService: urlService,
+		branding:   branding,
 	}
 }
 
@@ -141,6 +144,43 @@ func (h *URLHandler) ListURLs(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// @Summary 최근 생성 URL 조회
+// @Description 내가 최근에 생성한 단축 URL을 페이지네이션 없이 최신순으로 조회합니다. 삭제(비활성화)된 URL은 제외됩니다.
+// @Tags URLs
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "조회할 항목 수" default(10) minimum(1) maximum(50)
+// @Success 200 {object} domain.RecentURLsResponse "최근 생성된 URL 목록"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 요청"
+// @Failure 401 {object} domain.ErrorResponse "인증 실패"
+// @Failure 500 {object} domain.ErrorResponse "서버 내부 오류"
+// @Router /api/v1/urls/recent [get]
+func (h *URLHandler) ListRecentURLs(c *gin.Context) {
+	var options domain.RecentURLOptions
+	
+	if err := c.ShouldBindQuery(&options); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "validation_failed",
+			"message": "Invalid query parameters",
+			"details": map[string]interface{}{
+				"validation_error": err.Error(),
+			},
+		})
+		return
+	}
+	
+	apiKey := middleware.GetAPIKeyFromContext(c)
+	
+	response, err := h.urlService.ListRecentURLs(c.Request.Context(), apiKey, options)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	
+	c.JSON(http.StatusOK, response)
+}
+
 // PUT /api/v1/urls/:id
 func (h *URLHandler) UpdateURL(c *gin.Context) {
 	id := c.Param("id")
@@ -205,8 +245,8 @@ func (h *URLHandler) DeleteURL(c *gin.Context) {
 // @Produce html
 // @Param id path string true "단축 URL ID" example:"my-project"
 // @Success 301 "원본 URL로 영구 리다이렉트"
-// @Failure 404 {object} domain.ErrorResponse "URL을 찾을 수 없음"
-// @Failure 410 {object} domain.ErrorResponse "만료된 URL"
+// @Failure 404 {object} domain.ErrorResponse "URL을 찾을 수 없음 (Accept: text/html이면 HTML 페이지)"
+// @Failure 410 {object} domain.ErrorResponse "만료된 URL (Accept: text/html이면 HTML 페이지)"
 // @Failure 500 {object} domain.ErrorResponse "서버 내부 오류"
 // @Router /{id} [get]
 func (h *URLHandler) RedirectURL(c *gin.Context) {
@@ -221,6 +261,11 @@ func (h *URLHandler) RedirectURL(c *gin.Context) {
 	
 	url, err := h.urlService.GetURLForRedirect(c.Request.Context(), id)
 	if err != nil {
+		// 브라우저 요청에는 브랜딩이 적용된 HTML 에러 페이지를 보여줌
+		if wantsHTML(c) {
+			h.renderErrorPage(c, err)
+			return
+		}
 		h.handleError(c, err)
 		return
 	}
@@ -308,6 +353,88 @@ func (h *URLHandler) GetAnalytics(c *gin.Context) {
 	c.JSON(http.StatusOK, analytics)
 }
 
+// @Summary URL 소유권 이전
+// @Description 단축 URL의 소유 API 키를 다른 API 키로 이전합니다. 관리자 API 키가 필요합니다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "단축 URL ID" example:"my-project"
+// @Param request body domain.TransferURLRequest true "소유권 이전 요청"
+// @Success 200 {object} domain.TransferURLResponse "이전 결과"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 요청"
+// @Failure 401 {object} domain.ErrorResponse "인증 실패"
+// @Failure 404 {object} domain.ErrorResponse "URL을 찾을 수 없음"
+// @Failure 500 {object} domain.ErrorResponse "서버 내부 오류"
+// @Router /api/v1/admin/urls/{id}/transfer [post]
+func (h *URLHandler) TransferURL(c *gin.Context) {
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "validation_failed",
+			"message": "URL ID is required",
+		})
+		return
+	}
+	
+	var req domain.TransferURLRequest
+	
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "validation_failed",
+			"message": "Invalid request body",
+			"details": map[string]interface{}{
+				"validation_error": err.Error(),
+			},
+		})
+		return
+	}
+	
+	response, err := h.urlService.TransferURLs(c.Request.Context(), []string{id}, req.ToAPIKey)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	
+	c.JSON(http.StatusOK, response)
+}
+
+// @Summary URL 소유권 일괄 이전
+// @Description 여러 단축 URL의 소유 API 키를 한 번에 이전합니다. 하나라도 실패하면 전체가 롤백됩니다. 관리자 API 키가 필요합니다.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body domain.BulkTransferURLRequest true "소유권 일괄 이전 요청"
+// @Success 200 {object} domain.TransferURLResponse "이전 결과"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 요청"
+// @Failure 401 {object} domain.ErrorResponse "인증 실패"
+// @Failure 404 {object} domain.ErrorResponse "URL을 찾을 수 없음"
+// @Failure 500 {object} domain.ErrorResponse "서버 내부 오류"
+// @Router /api/v1/admin/urls/transfer [post]
+func (h *URLHandler) BulkTransferURLs(c *gin.Context) {
+	var req domain.BulkTransferURLRequest
+	
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "validation_failed",
+			"message": "Invalid request body",
+			"details": map[string]interface{}{
+				"validation_error": err.Error(),
+			},
+		})
+		return
+	}
+	
+	response, err := h.urlService.TransferURLs(c.Request.Context(), req.IDs, req.ToAPIKey)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	
+	c.JSON(http.StatusOK, response)
+}
+
 func (h *URLHandler) handleError(c *gin.Context, err error) {
 	if serviceErr, ok := err.(*service.ServiceError); ok {
 		statusCode := h.getHTTPStatusFromErrorCode(serviceErr.Code)
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"go-url-shortener/internal/config"
+	"go-url-shortener/internal/domain"
+	"go-url-shortener/internal/middleware"
+	"go-url-shortener/internal/service"
+)
+
+// 최근 URL 조회에 필요한 메서드만 구현한 테스트용 저장소
+type fakeRecentURLRepository struct {
+	fakeURLRepository
+	gotLimit int
+}
+
+func (r *fakeRecentURLRepository) ListRecent(ctx context.Context, apiKey string, limit int) ([]domain.URL, error) {
+	r.gotLimit = limit
+	return []domain.URL{}, nil
+}
+
+func TestListRecentURLsLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantLimit  int
+	}{
+		{"default limit", "", http.StatusOK, 10},
+		{"explicit limit", "?limit=25", http.StatusOK, 25},
+		{"maximum limit", "?limit=50", http.StatusOK, 50},
+		{"zero limit", "?limit=0", http.StatusBadRequest, 0},
+		{"negative limit", "?limit=-1", http.StatusBadRequest, 0},
+		{"over maximum", "?limit=51", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urlRepo := &fakeRecentURLRepository{}
+			urlService := service.NewURLService(urlRepo, &fakeCacheRepository{}, "http://localhost:8080", false, "sk_test")
+			urlHandler := NewURLHandler(urlService, config.Branding{PrimaryColor: "#4f46e5"})
+
+			router := gin.New()
+			router.GET("/api/v1/urls/recent", middleware.APIKeyAuth("sk_test"), urlHandler.ListRecentURLs)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/urls/recent"+tt.query, nil)
+			req.Header.Set("X-API-Key", "sk_test")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if urlRepo.gotLimit != tt.wantLimit {
+				t.Errorf("repository limit = %d, want %d", urlRepo.gotLimit, tt.wantLimit)
+			}
+		})
+	}
+}
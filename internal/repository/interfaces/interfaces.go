@@ -13,11 +13,13 @@ type URLRepository interface {
 	Update(ctx context.Context, url *domain.URL) error
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, apiKey string, options domain.URLListOptions) ([]domain.URL, int64, error)
+	ListRecent(ctx context.Context, apiKey string, limit int) ([]domain.URL, error)
 	ExistsByID(ctx context.Context, id string) (bool, error)
 	IncrementClickCount(ctx context.Context, id string) error
 	UpdateLastAccessed(ctx context.Context, id string) error
 	GetExpiredURLs(ctx context.Context, limit int) ([]domain.URL, error)
 	DeleteExpiredURLs(ctx context.Context, before time.Time) (int64, error)
+	TransferOwnership(ctx context.Context, ids []string, toAPIKey string) (map[string]string, error)
 }
 
 type AnalyticsRepository interface {
@@ -19,6 +19,31 @@ func NewURLRepository(db *sql.DB) interfaces.URLRepository {
 	return &urlRepository{db: db}
 }
 
+// urlColumns와 scanURL은 URL 조회 쿼리의 컬럼 순서를 한 곳에서 관리합니다
+const urlColumns = `id, original_url, description, expires_at, created_at, updated_at,
+			   click_count, is_active, last_accessed_at, created_by_api_key`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanURL(row rowScanner) (domain.URL, error) {
+	var url domain.URL
+	err := row.Scan(
+		&url.ID,
+		&url.OriginalURL,
+		&url.Description,
+		&url.ExpiresAt,
+		&url.CreatedAt,
+		&url.UpdatedAt,
+		&url.ClickCount,
+		&url.IsActive,
+		&url.LastAccessedAt,
+		&url.CreatedByAPIKey,
+	)
+	return url, err
+}
+
 func (r *urlRepository) Create(ctx context.Context, url *domain.URL) error {
 	query := `
 		INSERT INTO urls (id, original_url, description, expires_at, created_at, updated_at, 
@@ -49,25 +74,11 @@ func (r *urlRepository) Create(ctx context.Context, url *domain.URL) error {
 
 func (r *urlRepository) GetByID(ctx context.Context, id string) (*domain.URL, error) {
 	query := `
-		SELECT id, original_url, description, expires_at, created_at, updated_at,
-			   click_count, is_active, last_accessed_at, created_by_api_key
+		SELECT ` + urlColumns + `
 		FROM urls 
 		WHERE id = $1 AND is_active = true`
 	
-	url := &domain.URL{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&url.ID,
-		&url.OriginalURL,
-		&url.Description,
-		&url.ExpiresAt,
-		&url.CreatedAt,
-		&url.UpdatedAt,
-		&url.ClickCount,
-		&url.IsActive,
-		&url.LastAccessedAt,
-		&url.CreatedByAPIKey,
-	)
-	
+	url, err := scanURL(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("URL with ID '%s' not found", id)
@@ -75,7 +86,7 @@ func (r *urlRepository) GetByID(ctx context.Context, id string) (*domain.URL, er
 		return nil, fmt.Errorf("failed to get URL: %w", err)
 	}
 	
-	return url, nil
+	return &url, nil
 }
 
 func (r *urlRepository) Update(ctx context.Context, url *domain.URL) error {
@@ -167,8 +178,7 @@ func (r *urlRepository) List(ctx context.Context, apiKey string, options domain.
 	// 목록 조회
 	offset := (options.Page - 1) * options.Limit
 	query := fmt.Sprintf(`
-		SELECT id, original_url, description, expires_at, created_at, updated_at,
-			   click_count, is_active, last_accessed_at, created_by_api_key
+		SELECT ` + urlColumns + `
 		FROM urls 
 		%s
 		ORDER BY %s %s
@@ -185,19 +195,7 @@ func (r *urlRepository) List(ctx context.Context, apiKey string, options domain.
 	
 	var urls []domain.URL
 	for rows.Next() {
-		var url domain.URL
-		err := rows.Scan(
-			&url.ID,
-			&url.OriginalURL,
-			&url.Description,
-			&url.ExpiresAt,
-			&url.CreatedAt,
-			&url.UpdatedAt,
-			&url.ClickCount,
-			&url.IsActive,
-			&url.LastAccessedAt,
-			&url.CreatedByAPIKey,
-		)
+		url, err := scanURL(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan URL: %w", err)
 		}
@@ -211,6 +209,37 @@ func (r *urlRepository) List(ctx context.Context, apiKey string, options domain.
 	return urls, totalCount, nil
 }
 
+// ListRecent는 카운트 쿼리 없이 최근 생성된 활성 URL을 limit개만 조회합니다
+func (r *urlRepository) ListRecent(ctx context.Context, apiKey string, limit int) ([]domain.URL, error) {
+	query := `
+		SELECT ` + urlColumns + `
+		FROM urls 
+		WHERE created_by_api_key = $1 AND is_active = true
+		ORDER BY created_at DESC
+		LIMIT $2`
+	
+	rows, err := r.db.QueryContext(ctx, query, apiKey, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent URLs: %w", err)
+	}
+	defer rows.Close()
+	
+	urls := []domain.URL{}
+	for rows.Next() {
+		url, err := scanURL(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recent URL: %w", err)
+		}
+		urls = append(urls, url)
+	}
+	
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	
+	return urls, nil
+}
+
 func (r *urlRepository) ExistsByID(ctx context.Context, id string) (bool, error) {
 	query := "SELECT EXISTS(SELECT 1 FROM urls WHERE id = $1)"
 	
@@ -276,10 +305,9 @@ func (r *urlRepository) UpdateLastAccessed(ctx context.Context, id string) error
 // GetExpiredURLs는 만료된 URL 목록을 조회합니다
 func (r *urlRepository) GetExpiredURLs(ctx context.Context, limit int) ([]domain.URL, error) {
 	query := `
-		SELECT id, original_url, description, expires_at, created_at, updated_at,
-			   click_count, is_active, last_accessed_at, created_by_api_key
+		SELECT ` + urlColumns + `
 		FROM urls 
-		WHERE expires_at < $1 AND is_active = true
+		WHERE expires_at <= $1 AND is_active = true
 		ORDER BY expires_at ASC
 		LIMIT $2`
 	
@@ -291,19 +319,7 @@ func (r *urlRepository) GetExpiredURLs(ctx context.Context, limit int) ([]domain
 	
 	var urls []domain.URL
 	for rows.Next() {
-		var url domain.URL
-		err := rows.Scan(
-			&url.ID,
-			&url.OriginalURL,
-			&url.Description,
-			&url.ExpiresAt,
-			&url.CreatedAt,
-			&url.UpdatedAt,
-			&url.ClickCount,
-			&url.IsActive,
-			&url.LastAccessedAt,
-			&url.CreatedByAPIKey,
-		)
+		url, err := scanURL(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan expired URL: %w", err)
 		}
@@ -318,7 +334,7 @@ func (r *urlRepository) GetExpiredURLs(ctx context.Context, limit int) ([]domain
 }
 
 func (r *urlRepository) DeleteExpiredURLs(ctx context.Context, before time.Time) (int64, error) {
-	query := `UPDATE urls SET is_active = false, updated_at = $1 WHERE expires_at < $2 AND is_active = true`
+	query := `UPDATE urls SET is_active = false, updated_at = $1 WHERE expires_at <= $2 AND is_active = true`
 	
 	result, err := r.db.ExecContext(ctx, query, time.Now(), before)
 	if err != nil {
@@ -331,4 +347,46 @@ func (r *urlRepository) DeleteExpiredURLs(ctx context.Context, before time.Time)
 	}
 	
 	return rowsAffected, nil
-}
\ No newline at end of file
+}
+
+// TransferOwnership은 트랜잭션 안에서 URL들의 소유 API 키를 변경하고 이전 소유 API 키를 반환합니다
+// 비활성화된 URL도 함께 이전하며, 하나라도 존재하지 않으면 전체 변경을 롤백합니다
+// 이미 toAPIKey 소유인 URL은 변경하지 않습니다
+func (r *urlRepository) TransferOwnership(ctx context.Context, ids []string, toAPIKey string) (map[string]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+	
+	selectQuery := `SELECT created_by_api_key FROM urls WHERE id = $1 FOR UPDATE`
+	updateQuery := `UPDATE urls SET created_by_api_key = $1, updated_at = $2 WHERE id = $3`
+	
+	now := time.Now()
+	previousOwners := make(map[string]string, len(ids))
+	for _, id := range ids {
+		var fromAPIKey string
+		err := tx.QueryRowContext(ctx, selectQuery, id).Scan(&fromAPIKey)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("URL with ID '%s' not found", id)
+			}
+			return nil, fmt.Errorf("failed to get URL owner: %w", err)
+		}
+		
+		previousOwners[id] = fromAPIKey
+		if fromAPIKey == toAPIKey {
+			continue
+		}
+		
+		if _, err := tx.ExecContext(ctx, updateQuery, toAPIKey, now, id); err != nil {
+			return nil, fmt.Errorf("failed to transfer URL ownership: %w", err)
+		}
+	}
+	
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	
+	return previousOwners, nil
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
 	"strings"
 	"time"
@@ -11,24 +13,29 @@ import (
 )
 
 type URLService struct {
-	urlRepo     interfaces.URLRepository
-	cacheRepo   interfaces.CacheRepository
-	idGenerator *IDGenerator
-	baseURL     string
+	urlRepo      interfaces.URLRepository
+	cacheRepo    interfaces.CacheRepository
+	idGenerator  *IDGenerator
+	baseURL      string
+	requireHTTPS bool
+	validAPIKey  string
 }
 
-func NewURLService(urlRepo interfaces.URLRepository, cacheRepo interfaces.CacheRepository, baseURL string) *URLService {
+// validAPIKey는 서버가 인증을 허용하는 API 키로, 소유권 이전 대상 검증에 사용됩니다
+func NewURLService(urlRepo interfaces.URLRepository, cacheRepo interfaces.CacheRepository, baseURL string, requireHTTPS bool, validAPIKey string) *URLService {
 	return &URLService{
-		urlRepo:     urlRepo,
-		cacheRepo:   cacheRepo,
-		idGenerator: NewIDGenerator(6),
-		baseURL:     baseURL,
+		urlRepo:      urlRepo,
+		cacheRepo:    cacheRepo,
+		idGenerator:  NewIDGenerator(6),
+		baseURL:      baseURL,
+		requireHTTPS: requireHTTPS,
+		validAPIKey:  strings.TrimSpace(validAPIKey),
 	}
 }
 
 func (s *URLService) CreateShortURL(ctx context.Context, req domain.CreateURLRequest, apiKey string) (*domain.URL, error) {
 	// 원본 URL 유효성 검사
-	if err := domain.ValidateOriginalURL(req.OriginalURL); err != nil {
+	if err := domain.ValidateOriginalURL(req.OriginalURL, s.requireHTTPS); err != nil {
 		return nil, NewValidationError("original_url", err.Error(), nil)
 	}
 
@@ -61,6 +68,11 @@ func (s *URLService) CreateShortURL(ctx context.Context, req domain.CreateURLReq
 				return nil, NewInternalError("Failed to generate ID")
 			}
 			
+			// 라우트 경로와 겹치는 예약어는 건너뜀
+			if domain.IsReservedID(generatedID) {
+				continue
+			}
+			
 			exists, err := s.urlRepo.ExistsByID(ctx, generatedID)
 			if err != nil {
 				return nil, NewInternalError("Failed to check ID availability")
@@ -101,8 +113,14 @@ func (s *URLService) CreateShortURL(ctx context.Context, req domain.CreateURLReq
 }
 
 func (s *URLService) GetURL(ctx context.Context, id string) (*domain.URL, error) {
+	// 캐시/DB 경로 모두 같은 시각 기준으로 만료 여부를 판단
+	now := time.Now()
+
 	url, err := s.cacheRepo.GetURL(ctx, id)
 	if err == nil {
+		if err := checkAccessible(url, now); err != nil {
+			return nil, err
+		}
 		url.BuildShortURL(s.baseURL)
 		url.BuildQRCodeURL(s.baseURL)
 		return url, nil
@@ -117,23 +135,42 @@ func (s *URLService) GetURL(ctx context.Context, id string) (*domain.URL, error)
 		return nil, NewInternalError("Failed to retrieve URL")
 	}
 
-	if !url.IsAccessible() {
-		if url.IsExpired() {
-			return nil, NewExpiredError("Short URL")
-		}
-		return nil, NewNotFoundError("Short URL")
+	if err := checkAccessible(url, now); err != nil {
+		return nil, err
 	}
 
 	url.BuildShortURL(s.baseURL)
 	url.BuildQRCodeURL(s.baseURL)
 
-	if err := s.cacheRepo.SetURL(ctx, url, 5*time.Minute); err != nil {
+	if err := s.cacheRepo.SetURL(ctx, url, cacheTTL(url, now)); err != nil {
 		log.Printf("Failed to cache URL: %v", err)
 	}
 
 	return url, nil
 }
 
+// checkAccessible은 now 기준으로 URL이 만료되었거나 비활성 상태이면 에러를 반환합니다
+func checkAccessible(url *domain.URL, now time.Time) error {
+	if url.IsAccessibleAt(now) {
+		return nil
+	}
+	if url.IsExpiredAt(now) {
+		return NewExpiredError("Short URL")
+	}
+	return NewNotFoundError("Short URL")
+}
+
+// cacheTTL은 캐시가 URL의 만료 시각을 넘어서 남지 않도록 TTL을 계산합니다
+func cacheTTL(url *domain.URL, now time.Time) time.Duration {
+	ttl := 5 * time.Minute
+	if url.ExpiresAt != nil {
+		if remaining := url.ExpiresAt.Sub(now); remaining < ttl {
+			ttl = remaining
+		}
+	}
+	return ttl
+}
+
 func (s *URLService) GetURLForRedirect(ctx context.Context, id string) (*domain.URL, error) {
 	url, err := s.GetURL(ctx, id)
 	if err != nil {
@@ -201,6 +238,30 @@ func (s *URLService) ListURLs(ctx context.Context, apiKey string, options domain
 	}, nil
 }
 
+func (s *URLService) ListRecentURLs(ctx context.Context, apiKey string, options domain.RecentURLOptions) (*domain.RecentURLsResponse, error) {
+	// 기본값 설정 (범위 검증은 핸들러의 바인딩에서 수행)
+	limit := 10
+	if options.Limit != nil {
+		limit = *options.Limit
+	}
+
+	urls, err := s.urlRepo.ListRecent(ctx, apiKey, limit)
+	if err != nil {
+		log.Printf("Failed to list recent URLs: %v", err)
+		return nil, NewInternalError("Failed to retrieve recent URLs")
+	}
+
+	// URL 빌드
+	for i := range urls {
+		urls[i].BuildShortURL(s.baseURL)
+		urls[i].BuildQRCodeURL(s.baseURL)
+	}
+
+	return &domain.RecentURLsResponse{
+		URLs: urls,
+	}, nil
+}
+
 func (s *URLService) UpdateURL(ctx context.Context, id string, req domain.UpdateURLRequest, apiKey string) (*domain.URL, error) {
 	url, err := s.urlRepo.GetByID(ctx, id)
 	if err != nil {
@@ -215,7 +276,7 @@ func (s *URLService) UpdateURL(ctx context.Context, id string, req domain.Update
 	}
 
 	if req.OriginalURL != nil {
-		if err := domain.ValidateOriginalURL(*req.OriginalURL); err != nil {
+		if err := domain.ValidateOriginalURL(*req.OriginalURL, s.requireHTTPS); err != nil {
 			return nil, NewValidationError("original_url", err.Error(), nil)
 		}
 		url.OriginalURL = *req.OriginalURL
@@ -305,4 +366,74 @@ func (s *URLService) CleanupExpiredURLs(ctx context.Context) (int64, error) {
 
 	log.Printf("Cleaned up %d expired URLs", deleted)
 	return deleted, nil
-}
\ No newline at end of file
+}
+
+// TransferURLs는 관리자 요청으로 URL들의 소유 API 키를 toAPIKey로 이전합니다
+func (s *URLService) TransferURLs(ctx context.Context, ids []string, toAPIKey string) (*domain.TransferURLResponse, error) {
+	toAPIKey = strings.TrimSpace(toAPIKey)
+	if toAPIKey == "" {
+		return nil, NewValidationError("to_api_key", "Target API key is required", nil)
+	}
+
+	// 인증이 불가능한 키로 옮기면 링크가 다시 고아가 되므로 서버가 허용하는 키만 대상으로 함
+	if toAPIKey != s.validAPIKey {
+		return nil, NewValidationError("to_api_key", "Target API key is not a valid API key", nil)
+	}
+
+	// 중복 ID 제거
+	seen := make(map[string]bool, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return nil, NewValidationError("ids", "URL ID is required", nil)
+		}
+		if !seen[id] {
+			seen[id] = true
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	if len(uniqueIDs) == 0 {
+		return nil, NewValidationError("ids", "At least one URL ID is required", nil)
+	}
+
+	previousOwners, err := s.urlRepo.TransferOwnership(ctx, uniqueIDs, toAPIKey)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			// 일괄 이전이 어떤 ID 때문에 롤백되었는지 알 수 있도록 저장소 메시지를 그대로 전달
+			notFoundErr := NewNotFoundError("Short URL")
+			notFoundErr.Message = err.Error()
+			return nil, notFoundErr
+		}
+		log.Printf("Failed to transfer URL ownership: %v", err)
+		return nil, NewInternalError("Failed to transfer URL ownership")
+	}
+
+	// 감사 로그 및 캐시 무효화 (이미 대상 키 소유였던 URL은 제외)
+	transferredIDs := make([]string, 0, len(uniqueIDs))
+	for _, id := range uniqueIDs {
+		if previousOwners[id] == toAPIKey {
+			continue
+		}
+		transferredIDs = append(transferredIDs, id)
+
+		log.Printf("[AUDIT] Transferred ownership of URL %s from API key %s to API key %s",
+			id, apiKeyFingerprint(previousOwners[id]), apiKeyFingerprint(toAPIKey))
+
+		if err := s.cacheRepo.DeleteURL(ctx, id); err != nil {
+			log.Printf("Failed to invalidate cache for URL %s: %v", id, err)
+		}
+	}
+
+	return &domain.TransferURLResponse{
+		IDs:              transferredIDs,
+		TransferredCount: len(transferredIDs),
+	}, nil
+}
+
+// apiKeyFingerprint는 로그에서 API 키를 구분할 수 있도록 원문 대신 SHA-256 해시 앞부분을 반환합니다
+func apiKeyFingerprint(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return "sha256:" + hex.EncodeToString(sum[:])[:12]
+}
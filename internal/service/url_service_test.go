@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"go-url-shortener/internal/domain"
+	"go-url-shortener/internal/repository/interfaces"
+)
+
+func TestCacheTTL(t *testing.T) {
+	now := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt *time.Time
+		want      time.Duration
+	}{
+		{"no expiry", nil, 5 * time.Minute},
+		{"expires after default TTL", timePtr(now.Add(time.Hour)), 5 * time.Minute},
+		{"expires before default TTL", timePtr(now.Add(30 * time.Second)), 30 * time.Second},
+		{"expires 1ns from now", timePtr(now.Add(time.Nanosecond)), time.Nanosecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := &domain.URL{IsActive: true, ExpiresAt: tt.expiresAt}
+
+			got := cacheTTL(url, now)
+			if got != tt.want {
+				t.Errorf("cacheTTL() = %v, want %v", got, tt.want)
+			}
+			if tt.expiresAt != nil && now.Add(got).After(*tt.expiresAt) {
+				t.Errorf("cacheTTL() = %v outlives expires_at %v", got, *tt.expiresAt)
+			}
+		})
+	}
+}
+
+func TestCheckAccessibleAtExpiry(t *testing.T) {
+	expiresAt := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	url := &domain.URL{IsActive: true, ExpiresAt: &expiresAt}
+
+	if err := checkAccessible(url, expiresAt.Add(-time.Nanosecond)); err != nil {
+		t.Errorf("checkAccessible() before expires_at = %v, want nil", err)
+	}
+
+	err := checkAccessible(url, expiresAt)
+	serviceErr, ok := err.(*ServiceError)
+	if !ok || serviceErr.Code != ErrCodeExpired {
+		t.Errorf("checkAccessible() at expires_at = %v, want %s", err, ErrCodeExpired)
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+// 소유권 이전에 필요한 메서드만 구현한 메모리 저장소
+type fakeURLRepository struct {
+	interfaces.URLRepository
+	owners      map[string]string
+	transferred [][]string
+}
+
+func (r *fakeURLRepository) TransferOwnership(ctx context.Context, ids []string, toAPIKey string) (map[string]string, error) {
+	r.transferred = append(r.transferred, ids)
+
+	previousOwners := make(map[string]string, len(ids))
+	for _, id := range ids {
+		owner, ok := r.owners[id]
+		if !ok {
+			return nil, fmt.Errorf("URL with ID '%s' not found", id)
+		}
+		previousOwners[id] = owner
+	}
+
+	for _, id := range ids {
+		r.owners[id] = toAPIKey
+	}
+	return previousOwners, nil
+}
+
+type fakeCacheRepository struct {
+	interfaces.CacheRepository
+	deleted []string
+}
+
+func (r *fakeCacheRepository) DeleteURL(ctx context.Context, id string) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func TestTransferURLs(t *testing.T) {
+	const targetKey = "sk_marsboy_new_key"
+
+	tests := []struct {
+		name            string
+		ids             []string
+		toAPIKey        string
+		wantCode        ErrorCode
+		wantMessage     string
+		wantRepoIDs     []string
+		wantTransferred []string
+	}{
+		{
+			name:            "trims and deduplicates IDs",
+			ids:             []string{" a ", "a", "b"},
+			toAPIKey:        targetKey,
+			wantRepoIDs:     []string{"a", "b"},
+			wantTransferred: []string{"a", "b"},
+		},
+		{
+			name:            "trims target key",
+			ids:             []string{"a"},
+			toAPIKey:        " " + targetKey + " ",
+			wantRepoIDs:     []string{"a"},
+			wantTransferred: []string{"a"},
+		},
+		{
+			name:            "skips URLs already owned by target",
+			ids:             []string{"a", "owned"},
+			toAPIKey:        targetKey,
+			wantRepoIDs:     []string{"a", "owned"},
+			wantTransferred: []string{"a"},
+		},
+		{
+			name:     "rejects empty ID",
+			ids:      []string{"a", " "},
+			toAPIKey: targetKey,
+			wantCode: ErrCodeValidation,
+		},
+		{
+			name:     "rejects empty ID list",
+			ids:      nil,
+			toAPIKey: targetKey,
+			wantCode: ErrCodeValidation,
+		},
+		{
+			name:     "rejects empty target key",
+			ids:      []string{"a"},
+			toAPIKey: "",
+			wantCode: ErrCodeValidation,
+		},
+		{
+			name:     "rejects target key the server does not accept",
+			ids:      []string{"a"},
+			toAPIKey: "sk_marsboy_typo_key",
+			wantCode: ErrCodeValidation,
+		},
+		{
+			name:        "reports which ID was not found",
+			ids:         []string{"a", "missing"},
+			toAPIKey:    targetKey,
+			wantCode:    ErrCodeNotFound,
+			wantMessage: "missing",
+			wantRepoIDs: []string{"a", "missing"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urlRepo := &fakeURLRepository{
+				owners: map[string]string{
+					"a":     "sk_marsboy_old_key",
+					"b":     "sk_marsboy_old_key",
+					"owned": targetKey,
+				},
+			}
+			cacheRepo := &fakeCacheRepository{}
+			s := NewURLService(urlRepo, cacheRepo, "http://localhost:8080", false, targetKey)
+
+			response, err := s.TransferURLs(context.Background(), tt.ids, tt.toAPIKey)
+
+			if tt.wantCode != "" {
+				serviceErr, ok := err.(*ServiceError)
+				if !ok || serviceErr.Code != tt.wantCode {
+					t.Fatalf("TransferURLs() error = %v, want %s", err, tt.wantCode)
+				}
+				if !strings.Contains(serviceErr.Message, tt.wantMessage) {
+					t.Errorf("error message = %q, want it to contain %q", serviceErr.Message, tt.wantMessage)
+				}
+				if len(cacheRepo.deleted) != 0 {
+					t.Errorf("cache invalidated for %v on failure", cacheRepo.deleted)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("TransferURLs() error = %v", err)
+				}
+				if !reflect.DeepEqual(response.IDs, tt.wantTransferred) || response.TransferredCount != len(tt.wantTransferred) {
+					t.Errorf("response = %+v, want IDs %v", response, tt.wantTransferred)
+				}
+				if !reflect.DeepEqual(cacheRepo.deleted, tt.wantTransferred) {
+					t.Errorf("cache invalidated for %v, want %v", cacheRepo.deleted, tt.wantTransferred)
+				}
+			}
+
+			var repoIDs []string
+			if len(urlRepo.transferred) > 0 {
+				repoIDs = urlRepo.transferred[0]
+			}
+			if !reflect.DeepEqual(repoIDs, tt.wantRepoIDs) {
+				t.Errorf("repository received %v, want %v", repoIDs, tt.wantRepoIDs)
+			}
+		})
+	}
+}
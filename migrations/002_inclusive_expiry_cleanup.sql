@@ -0,0 +1,16 @@
+-- 002_inclusive_expiry_cleanup.sql
+-- 만료 경계를 포함(inclusive)으로 통일: expires_at 시각부터 만료된 것으로 처리
+
+CREATE OR REPLACE FUNCTION cleanup_expired_urls()
+RETURNS INTEGER AS $$
+DECLARE
+    deleted_count INTEGER;
+BEGIN
+    UPDATE urls 
+    SET is_active = false, updated_at = NOW()
+    WHERE expires_at <= NOW() AND is_active = true;
+    
+    GET DIAGNOSTICS deleted_count = ROW_COUNT;
+    RETURN deleted_count;
+END;
+$$ LANGUAGE plpgsql;